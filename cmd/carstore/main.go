@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/auth"
+	"github.com/A-mo21/CarStore_dummyProject/internal/car"
+	"github.com/A-mo21/CarStore_dummyProject/internal/health"
+	"github.com/A-mo21/CarStore_dummyProject/internal/jsonapi"
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/middleware"
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage/mongodb"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// shutdownTimeout bounds how long we wait for in-flight requests and the
+// Mongo connection to close on SIGINT/SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
+func databaseConfigFromEnv() mongodb.DatabaseConfig {
+	return mongodb.DatabaseConfig{
+		Auth: os.Getenv("DB_AUTH"),
+		Host: os.Getenv("DB_HOST"),
+		Port: os.Getenv("DB_PORT"),
+		User: os.Getenv("DB_USER"),
+		Pass: os.Getenv("DB_PASS"),
+		Name: os.Getenv("DB_NAME"),
+	}
+}
+
+func main() {
+	logger := logrus.New()
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	if err := auth.RequireConfiguredSecret(); err != nil {
+		logger.WithError(err).Fatal("refusing to start with an unconfigured JWT secret")
+	}
+
+	db, client, err := mongodb.NewDatabase(context.Background(), databaseConfigFromEnv())
+	if err != nil {
+		logger.WithError(err).Fatal("failed to connect to MongoDB")
+	}
+
+	userStorer, err := mongodb.NewUserStorer(context.Background(), db)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to initialize user storage")
+	}
+
+	carController := car.NewController(mongodb.NewCarStorer(db))
+	authController := auth.NewController(userStorer)
+	healthController := health.NewController(func(ctx context.Context) error {
+		return client.Ping(ctx, nil)
+	})
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(middleware.RequestID())
+	router.Use(middleware.StructuredLogging(logger))
+	router.Use(cors.New(cors.Config{
+		AllowOrigins: []string{"http://localhost:5173"},
+		AllowMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowHeaders: []string{"Origin", "Content-Type", "Authorization"},
+	}))
+	router.Use(jsonapi.Negotiate())
+
+	router.GET("/api/ping", healthController.Ping)
+	router.GET("/healthz", healthController.Live)
+	router.GET("/readyz", healthController.Ready)
+
+	router.POST("/auth/register", authController.Register)
+	router.POST("/auth/login", authController.Login)
+	router.POST("/auth/refresh", authController.Refresh)
+
+	router.GET("/cars", carController.GetAll)
+	router.GET("/cars/export.csv", carController.Export)
+	router.GET("/car/:id", carController.GetByID)
+
+	authorized := router.Group("/", auth.Authorize())
+	authorized.POST("/cars", carController.Create)
+	authorized.POST("/cars/bulk", carController.CreateBulk)
+	authorized.POST("/cars/import", carController.Import)
+	authorized.PUT("/car/:id", carController.Update)
+	authorized.DELETE("/car/:id", auth.RequireRole("admin"), carController.Delete)
+
+	srv := &http.Server{
+		Addr:    ":8080",
+		Handler: router,
+	}
+
+	go func() {
+		logger.WithField("addr", srv.Addr).Info("server starting")
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.WithError(err).Fatal("server failed")
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	logger.Info("shutdown signal received")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.WithError(err).Error("server shutdown error")
+	}
+	if err := client.Disconnect(shutdownCtx); err != nil {
+		logger.WithError(err).Error("mongodb disconnect error")
+	}
+
+	logger.Info("shutdown complete")
+}
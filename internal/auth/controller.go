@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultRole = "user"
+
+// Controller handles the account and session HTTP routes.
+type Controller struct {
+	users storage.UserStorer
+}
+
+// NewController returns a Controller backed by users.
+func NewController(users storage.UserStorer) *Controller {
+	return &Controller{users: users}
+}
+
+func requestTimeout() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 10*time.Second)
+}
+
+func (ctrl *Controller) Register(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	var req registerRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Username == "" || req.Password == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "username and password are required"})
+		return
+	}
+
+	hash, err := HashPassword(req.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		return
+	}
+
+	created, err := ctrl.users.Create(ctx, storage.User{
+		Username:     req.Username,
+		PasswordHash: hash,
+		Role:         defaultRole,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Username already taken"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newUserResponse(created))
+}
+
+func (ctrl *Controller) Login(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "username and password are required"})
+		return
+	}
+
+	user, err := ctrl.users.FindByUsername(ctx, req.Username)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+		return
+	}
+
+	if err := CheckPassword(user.PasswordHash, req.Password); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+		return
+	}
+
+	accessToken, refreshToken, err := issueTokens(user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sign in"})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokenResponse{AccessToken: accessToken, RefreshToken: refreshToken})
+}
+
+func (ctrl *Controller) Refresh(c *gin.Context) {
+	var req refreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.RefreshToken == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "refresh_token is required"})
+		return
+	}
+
+	claims, err := ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	accessToken, err := GenerateAccessToken(claims.UserID, claims.Role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh session"})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokenResponse{AccessToken: accessToken})
+}
+
+func issueTokens(user storage.User) (accessToken, refreshToken string, err error) {
+	accessToken, err = GenerateAccessToken(user.ID, user.Role)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err = GenerateRefreshToken(user.ID, user.Role)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+func newTestRouter(storer *fakeUserStorer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	ctrl := NewController(storer)
+
+	router := gin.New()
+	router.POST("/auth/register", ctrl.Register)
+	router.POST("/auth/login", ctrl.Login)
+	router.POST("/auth/refresh", ctrl.Refresh)
+	return router
+}
+
+func TestRegister_Success(t *testing.T) {
+	storer := &fakeUserStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(`{"username":"alice","password":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	if len(storer.users) != 1 {
+		t.Fatalf("expected storer to hold 1 user, got %d", len(storer.users))
+	}
+	if storer.users[0].PasswordHash == "hunter2" {
+		t.Fatal("password was stored in plain text")
+	}
+}
+
+func TestRegister_MissingFields(t *testing.T) {
+	storer := &fakeUserStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(`{"username":"alice"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestRegister_DuplicateUsername(t *testing.T) {
+	storer := &fakeUserStorer{}
+	router := newTestRouter(storer)
+
+	body := `{"username":"alice","password":"hunter2"}`
+	req1 := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(body))
+	req1.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req1)
+
+	req2 := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(body))
+	req2.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req2)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestLogin_Success(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	hash, err := HashPassword("hunter2")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	storer := &fakeUserStorer{users: []storage.User{{ID: "1", Username: "alice", PasswordHash: hash, Role: "user"}}}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"alice","password":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	var resp tokenResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" {
+		t.Fatalf("got %+v, want both tokens set", resp)
+	}
+}
+
+func TestLogin_WrongPassword(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	hash, err := HashPassword("hunter2")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	storer := &fakeUserStorer{users: []storage.User{{ID: "1", Username: "alice", PasswordHash: hash, Role: "user"}}}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"alice","password":"wrong"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestLogin_UnknownUsername(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	storer := &fakeUserStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"nobody","password":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestRefresh_Success(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	refreshToken, err := GenerateRefreshToken("1", "user")
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken: %v", err)
+	}
+
+	router := newTestRouter(&fakeUserStorer{})
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(`{"refresh_token":"`+refreshToken+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestRefresh_InvalidToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	router := newTestRouter(&fakeUserStorer{})
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(`{"refresh_token":"garbage"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
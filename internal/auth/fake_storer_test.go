@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+)
+
+// fakeUserStorer is an in-memory storage.UserStorer used to unit test
+// Controller without a real database.
+type fakeUserStorer struct {
+	users     []storage.User
+	nextID    int
+	createErr error
+	findErr   error
+}
+
+func (f *fakeUserStorer) Create(_ context.Context, user storage.User) (storage.User, error) {
+	if f.createErr != nil {
+		return storage.User{}, f.createErr
+	}
+	for _, existing := range f.users {
+		if existing.Username == user.Username {
+			return storage.User{}, storage.ErrAlreadyExists
+		}
+	}
+
+	f.nextID++
+	user.ID = strconv.Itoa(f.nextID)
+	f.users = append(f.users, user)
+	return user, nil
+}
+
+func (f *fakeUserStorer) FindByUsername(_ context.Context, username string) (storage.User, error) {
+	if f.findErr != nil {
+		return storage.User{}, f.findErr
+	}
+	for _, existing := range f.users {
+		if existing.Username == username {
+			return existing, nil
+		}
+	}
+	return storage.User{}, storage.ErrNotFound
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+var errInvalidToken = errors.New("invalid or expired token")
+
+// ErrJWTSecretNotConfigured is returned by RequireConfiguredSecret when
+// JWT_SECRET is unset or empty. Signing or validating tokens with an empty
+// secret is equivalent to running without authentication, so callers must
+// check this before serving traffic.
+var ErrJWTSecretNotConfigured = errors.New("JWT_SECRET is not set")
+
+// Claims are the identity carried by a validated access or refresh token.
+type Claims struct {
+	UserID string
+	Role   string
+}
+
+func secret() []byte {
+	return []byte(os.Getenv("JWT_SECRET"))
+}
+
+// RequireConfiguredSecret reports ErrJWTSecretNotConfigured if JWT_SECRET is
+// unset or empty. Call this once at startup and fail fast on error, rather
+// than silently signing every token with an empty, guessable key.
+func RequireConfiguredSecret() error {
+	if len(secret()) == 0 {
+		return ErrJWTSecretNotConfigured
+	}
+	return nil
+}
+
+func newToken(userID, role, tokenType string, ttl time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"uid":  userID,
+		"role": role,
+		"typ":  tokenType,
+		"exp":  jwt.NewNumericDate(time.Now().Add(ttl)),
+		"iat":  jwt.NewNumericDate(time.Now()),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(secret())
+}
+
+// GenerateAccessToken issues a short-lived JWT identifying userID and role.
+func GenerateAccessToken(userID, role string) (string, error) {
+	return newToken(userID, role, tokenTypeAccess, accessTokenTTL)
+}
+
+// GenerateRefreshToken issues a long-lived JWT used solely to obtain new
+// access tokens via POST /auth/refresh.
+func GenerateRefreshToken(userID, role string) (string, error) {
+	return newToken(userID, role, tokenTypeRefresh, refreshTokenTTL)
+}
+
+func parseToken(tokenString, wantType string) (*Claims, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errInvalidToken
+		}
+		return secret(), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, errInvalidToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errInvalidToken
+	}
+
+	typ, _ := claims["typ"].(string)
+	if typ != wantType {
+		return nil, errInvalidToken
+	}
+
+	userID, _ := claims["uid"].(string)
+	role, _ := claims["role"].(string)
+	if userID == "" {
+		return nil, errInvalidToken
+	}
+
+	return &Claims{UserID: userID, Role: role}, nil
+}
+
+// ParseAccessToken validates an access token and returns its claims.
+func ParseAccessToken(tokenString string) (*Claims, error) {
+	return parseToken(tokenString, tokenTypeAccess)
+}
+
+// ParseRefreshToken validates a refresh token and returns its claims.
+func ParseRefreshToken(tokenString string) (*Claims, error) {
+	return parseToken(tokenString, tokenTypeRefresh)
+}
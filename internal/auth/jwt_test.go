@@ -0,0 +1,87 @@
+package auth
+
+import "testing"
+
+func TestRequireConfiguredSecret(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "")
+		if err := RequireConfiguredSecret(); err != ErrJWTSecretNotConfigured {
+			t.Fatalf("err = %v, want %v", err, ErrJWTSecretNotConfigured)
+		}
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "a-test-secret")
+		if err := RequireConfiguredSecret(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestAccessTokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	token, err := GenerateAccessToken("user-1", "admin")
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	claims, err := ParseAccessToken(token)
+	if err != nil {
+		t.Fatalf("ParseAccessToken: %v", err)
+	}
+	if claims.UserID != "user-1" || claims.Role != "admin" {
+		t.Fatalf("got %+v", claims)
+	}
+}
+
+func TestRefreshTokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	token, err := GenerateRefreshToken("user-1", "admin")
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken: %v", err)
+	}
+
+	claims, err := ParseRefreshToken(token)
+	if err != nil {
+		t.Fatalf("ParseRefreshToken: %v", err)
+	}
+	if claims.UserID != "user-1" || claims.Role != "admin" {
+		t.Fatalf("got %+v", claims)
+	}
+}
+
+func TestParseAccessToken_RejectsRefreshToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	token, err := GenerateRefreshToken("user-1", "admin")
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken: %v", err)
+	}
+
+	if _, err := ParseAccessToken(token); err != errInvalidToken {
+		t.Fatalf("err = %v, want %v", err, errInvalidToken)
+	}
+}
+
+func TestParseAccessToken_RejectsTokenSignedWithDifferentSecret(t *testing.T) {
+	t.Setenv("JWT_SECRET", "secret-a")
+	token, err := GenerateAccessToken("user-1", "admin")
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	t.Setenv("JWT_SECRET", "secret-b")
+	if _, err := ParseAccessToken(token); err != errInvalidToken {
+		t.Fatalf("err = %v, want %v", err, errInvalidToken)
+	}
+}
+
+func TestParseAccessToken_RejectsGarbage(t *testing.T) {
+	t.Setenv("JWT_SECRET", "a-test-secret")
+
+	if _, err := ParseAccessToken("not-a-jwt"); err != errInvalidToken {
+		t.Fatalf("err = %v, want %v", err, errInvalidToken)
+	}
+}
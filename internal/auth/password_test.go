@@ -0,0 +1,17 @@
+package auth
+
+import "testing"
+
+func TestHashAndCheckPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+
+	if err := CheckPassword(hash, "correct horse battery staple"); err != nil {
+		t.Errorf("CheckPassword with the right password failed: %v", err)
+	}
+	if err := CheckPassword(hash, "wrong password"); err == nil {
+		t.Error("CheckPassword with the wrong password succeeded")
+	}
+}
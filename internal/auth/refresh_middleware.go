@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ctxKeyUserID = "userId"
+	ctxKeyRole   = "role"
+)
+
+// Authorize validates the Authorization: Bearer access token on the request
+// and injects userId/role into the gin context for downstream handlers.
+func Authorize() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		const prefix = "Bearer "
+		if !strings.HasPrefix(header, prefix) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing bearer token"})
+			return
+		}
+
+		claims, err := ParseAccessToken(strings.TrimPrefix(header, prefix))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			return
+		}
+
+		c.Set(ctxKeyUserID, claims.UserID)
+		c.Set(ctxKeyRole, claims.Role)
+		c.Next()
+	}
+}
+
+// RequireRole aborts the request with 403 unless Authorize has already
+// populated the context with the given role.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(ctxKeyRole) != role {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,18 @@
+package auth
+
+// registerRequest is the body accepted by POST /auth/register.
+type registerRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// loginRequest is the body accepted by POST /auth/login.
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// refreshRequest is the body accepted by POST /auth/refresh.
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,21 @@
+package auth
+
+import "github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+
+// userResponse is the JSON representation of an account, never including
+// the password hash.
+type userResponse struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+func newUserResponse(u storage.User) userResponse {
+	return userResponse{ID: u.ID, Username: u.Username, Role: u.Role}
+}
+
+// tokenResponse is returned by login and refresh.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
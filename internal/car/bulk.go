@@ -0,0 +1,245 @@
+package car
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/jsonapi"
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultImportBatchSize is how many rows CSV import buffers before issuing
+// an InsertMany.
+const defaultImportBatchSize = 500
+
+var exportHeader = []string{"id", "make", "model", "year", "price"}
+
+// CreateBulk inserts the JSON array of cars in req in a single round-trip,
+// reporting a per-item result so partial failures don't fail the whole
+// request. Items are decoded and validated individually rather than via
+// c.ShouldBindJSON, since gin's own []carRequest binding validation can't
+// report which item in the array actually failed.
+func (ctrl *Controller) CreateBulk(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	var reqs []carRequest
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqs); err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid car data", err.Error(), nil, gin.H{"error": "Invalid car data"})
+		return
+	}
+
+	if fields := fieldErrorsForBulk(reqs); fields != nil {
+		writeFieldErrors(c, fields)
+		return
+	}
+
+	cars := make([]storage.Car, len(reqs))
+	for i, req := range reqs {
+		cars[i] = req.toStorageCar()
+	}
+
+	results, err := ctrl.storer.CreateMany(ctx, cars)
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusInternalServerError, "Failed to add cars", "", nil, gin.H{"error": "Failed to add cars"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newBulkCreateResponse(results))
+}
+
+// Import reads a CSV file of cars (columns: make, model, year, price) from
+// multipart/form-data field "file" and inserts it in batches. With
+// ?dry_run=true, rows are validated but nothing is written.
+func (ctrl *Controller) Import(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	dryRun := c.Query("dry_run") == "true"
+
+	file, _, err := c.Request.FormFile("file")
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Missing file", "a \"file\" form field is required", nil, gin.H{"error": "a \"file\" form field is required"})
+		return
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid CSV", err.Error(), nil, gin.H{"error": "invalid CSV"})
+		return
+	}
+
+	columns, err := csvColumnIndex(header)
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid CSV", err.Error(), nil, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := importResponse{DryRun: dryRun}
+	batch := make([]storage.Car, 0, defaultImportBatchSize)
+	batchRows := make([]int, 0, defaultImportBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 || dryRun {
+			batch = batch[:0]
+			batchRows = batchRows[:0]
+			return nil
+		}
+
+		results, err := ctrl.storer.CreateMany(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for i, result := range results {
+			if result.Error != nil {
+				resp.Errors = append(resp.Errors, importRowError{Row: batchRows[i], Message: result.Error.Error()})
+				continue
+			}
+			resp.Inserted++
+		}
+		batch = batch[:0]
+		batchRows = batchRows[:0]
+		return nil
+	}
+
+	row := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			jsonapi.WriteError(c, http.StatusBadRequest, "Invalid CSV", err.Error(), nil, gin.H{"error": err.Error()})
+			return
+		}
+
+		parsed, err := csvRowToCar(record, columns)
+		if err != nil {
+			resp.Invalid++
+			resp.Errors = append(resp.Errors, importRowError{Row: row, Message: err.Error()})
+			continue
+		}
+		resp.Valid++
+
+		if dryRun {
+			continue
+		}
+
+		batch = append(batch, parsed)
+		batchRows = append(batchRows, row)
+		if len(batch) >= defaultImportBatchSize {
+			if err := flush(); err != nil {
+				jsonapi.WriteError(c, http.StatusInternalServerError, "Import failed", err.Error(), nil, gin.H{"error": "import failed"})
+				return
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		jsonapi.WriteError(c, http.StatusInternalServerError, "Import failed", err.Error(), nil, gin.H{"error": "import failed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Export streams every car matching the same filters as GetAll as CSV, so
+// large collections don't get buffered in memory.
+func (ctrl *Controller) Export(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	filter, err := parseListFilter(c)
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid filter", err.Error(), nil, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="cars.csv"`)
+
+	var exportErr error
+	c.Stream(func(w io.Writer) bool {
+		writer := csv.NewWriter(w)
+		defer writer.Flush()
+
+		if err := writer.Write(exportHeader); err != nil {
+			exportErr = err
+			return false
+		}
+
+		exportErr = ctrl.storer.Export(ctx, filter, func(car storage.Car) error {
+			return writer.Write([]string{
+				car.ID,
+				car.Make,
+				car.Model,
+				strconv.Itoa(car.Year),
+				strconv.FormatFloat(car.Price, 'f', 2, 64),
+			})
+		})
+		return false
+	})
+
+	if exportErr != nil {
+		log.Println("export cars:", exportErr)
+	}
+}
+
+var importRequiredColumns = []string{"make", "model", "year", "price"}
+
+// csvColumnIndex maps the required column names to their position in
+// header, regardless of order, and rejects a header missing any of them.
+func csvColumnIndex(header []string) (map[string]int, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, column := range importRequiredColumns {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("missing required column %q", column)
+		}
+	}
+
+	return index, nil
+}
+
+// csvRowToCar parses record into a car and applies the same validation
+// rules as POST /cars (carRequest's binding tags), so an import can't
+// silently insert a row /cars would have rejected with 422.
+func csvRowToCar(record []string, columns map[string]int) (storage.Car, error) {
+	year, err := strconv.Atoi(strings.TrimSpace(record[columns["year"]]))
+	if err != nil {
+		return storage.Car{}, fmt.Errorf("invalid year: %w", err)
+	}
+
+	price, err := strconv.ParseFloat(strings.TrimSpace(record[columns["price"]]), 64)
+	if err != nil {
+		return storage.Car{}, fmt.Errorf("invalid price: %w", err)
+	}
+
+	req := carRequest{
+		Make:  strings.TrimSpace(record[columns["make"]]),
+		Model: strings.TrimSpace(record[columns["model"]]),
+		Year:  year,
+		Price: price,
+	}
+
+	if err := validateCarRequest(req); err != nil {
+		return storage.Car{}, err
+	}
+
+	return req.toStorageCar(), nil
+}
@@ -0,0 +1,140 @@
+package car
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+)
+
+func TestCSVColumnIndex(t *testing.T) {
+	t.Run("maps columns regardless of order", func(t *testing.T) {
+		index, err := csvColumnIndex([]string{"price", "Make", " model ", "year"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string]int{"price": 0, "make": 1, "model": 2, "year": 3}
+		for col, wantIdx := range want {
+			if index[col] != wantIdx {
+				t.Errorf("index[%q] = %d, want %d", col, index[col], wantIdx)
+			}
+		}
+	})
+
+	t.Run("missing required column", func(t *testing.T) {
+		_, err := csvColumnIndex([]string{"make", "model", "year"})
+		if err == nil {
+			t.Fatal("expected an error for missing \"price\" column")
+		}
+	})
+}
+
+func TestCSVRowToCar(t *testing.T) {
+	columns, err := csvColumnIndex([]string{"make", "model", "year", "price"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		record  []string
+		wantErr bool
+	}{
+		{
+			name:   "valid row",
+			record: []string{"Toyota", "Corolla", "2020", "19999.99"},
+		},
+		{
+			name:    "non-numeric year",
+			record:  []string{"Toyota", "Corolla", "abc", "19999.99"},
+			wantErr: true,
+		},
+		{
+			name:    "non-numeric price",
+			record:  []string{"Toyota", "Corolla", "2020", "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "year out of range fails validation",
+			record:  []string{"Toyota", "Corolla", "0", "19999.99"},
+			wantErr: true,
+		},
+		{
+			name:    "negative price fails validation",
+			record:  []string{"Toyota", "Corolla", "2020", "-1"},
+			wantErr: true,
+		},
+		{
+			name:    "empty make fails validation",
+			record:  []string{"", "Corolla", "2020", "19999.99"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			car, err := csvRowToCar(tt.record, columns)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if car.Make != "Toyota" || car.Model != "Corolla" || car.Year != 2020 {
+				t.Fatalf("got %+v", car)
+			}
+		})
+	}
+}
+
+func TestNewBulkCreateResponse(t *testing.T) {
+	results := []storage.BulkCreateResult{
+		{Car: storage.Car{ID: "1", Make: "Toyota", Model: "Corolla"}},
+		{Error: errors.New("boom")},
+	}
+
+	resp := newBulkCreateResponse(results)
+
+	if resp.Inserted != 1 {
+		t.Errorf("Inserted = %d, want 1", resp.Inserted)
+	}
+	if resp.Failed != 1 {
+		t.Errorf("Failed = %d, want 1", resp.Failed)
+	}
+	if len(resp.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(resp.Items))
+	}
+	if resp.Items[0].Car == nil || resp.Items[0].Error != "" {
+		t.Errorf("Items[0] = %+v, want a car and no error", resp.Items[0])
+	}
+	if resp.Items[1].Car != nil || resp.Items[1].Error != "boom" {
+		t.Errorf("Items[1] = %+v, want error \"boom\" and no car", resp.Items[1])
+	}
+}
+
+func TestControllerCreateBulk_ValidationError(t *testing.T) {
+	storer := &fakeCarStorer{}
+	router := newTestRouter(storer)
+
+	body := `[{"make":"Toyota","model":"Corolla","year":2020,"price":100},{"make":"","model":"Civic","year":2020,"price":100}]`
+	req := httptest.NewRequest(http.MethodPost, "/cars/bulk", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "1.make") {
+		t.Fatalf("expected a field-level error naming the second item's \"make\" (index 1), got %s", w.Body.String())
+	}
+	if len(storer.cars) != 0 {
+		t.Fatalf("expected nothing to be created on validation failure, storer has %d cars", len(storer.cars))
+	}
+}
@@ -0,0 +1,142 @@
+package car
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/jsonapi"
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// Controller handles the car HTTP routes, delegating persistence to a
+// storage.CarStorer so it can be unit tested against a fake.
+type Controller struct {
+	storer storage.CarStorer
+}
+
+// NewController returns a Controller backed by storer.
+func NewController(storer storage.CarStorer) *Controller {
+	return &Controller{storer: storer}
+}
+
+func requestTimeout() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 10*time.Second)
+}
+
+func (ctrl *Controller) GetAll(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	opts, err := parseListOptions(c)
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid list options", err.Error(), nil, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter, err := parseListFilter(c)
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid list options", err.Error(), nil, gin.H{"error": err.Error()})
+		return
+	}
+
+	cars, total, err := ctrl.storer.FindAll(ctx, filter, opts)
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusInternalServerError, "Failed to fetch cars", "", nil, gin.H{"error": "Failed to fetch cars"})
+		return
+	}
+
+	resp := newListResponse(cars, opts, total)
+	jsonapi.WriteMany(c, http.StatusOK, resp.Items, jsonapi.CollectionMeta{
+		Total:    total,
+		Page:     opts.Page,
+		PageSize: opts.PageSize,
+		BasePath: "/cars",
+		Query:    c.Request.URL.Query(),
+	}, resp)
+}
+
+func (ctrl *Controller) Create(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	var req carRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeValidationError(c, err)
+		return
+	}
+
+	created, err := ctrl.storer.Create(ctx, req.toStorageCar())
+	if err != nil {
+		jsonapi.WriteError(c, http.StatusInternalServerError, "Failed to add car", "", nil, gin.H{"error": "Failed to add car"})
+		return
+	}
+
+	jsonapi.Write(c, http.StatusCreated, newCarResponse(created))
+}
+
+func (ctrl *Controller) GetByID(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	found, err := ctrl.storer.Find(ctx, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidID) {
+			jsonapi.WriteError(c, http.StatusBadRequest, "Invalid car ID", "", nil, gin.H{"error": "Invalid car ID"})
+			return
+		}
+		jsonapi.WriteError(c, http.StatusNotFound, "Car not found", "", nil, gin.H{"error": "Car not found"})
+		return
+	}
+
+	jsonapi.Write(c, http.StatusOK, newCarResponse(found))
+}
+
+func (ctrl *Controller) Update(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	var req carRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeValidationError(c, err)
+		return
+	}
+
+	updated, err := ctrl.storer.Update(ctx, c.Param("id"), req.toStorageCar())
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidID) {
+			jsonapi.WriteError(c, http.StatusBadRequest, "Invalid car ID", "", nil, gin.H{"error": "Invalid car ID"})
+			return
+		}
+		if errors.Is(err, storage.ErrNotFound) {
+			jsonapi.WriteError(c, http.StatusNotFound, "Car not found", "", nil, gin.H{"error": "Car not found"})
+			return
+		}
+		jsonapi.WriteError(c, http.StatusInternalServerError, "Failed to update car", "", nil, gin.H{"error": "Failed to update car"})
+		return
+	}
+
+	jsonapi.Write(c, http.StatusOK, newCarResponse(updated))
+}
+
+func (ctrl *Controller) Delete(c *gin.Context) {
+	ctx, cancel := requestTimeout()
+	defer cancel()
+
+	if err := ctrl.storer.Delete(ctx, c.Param("id")); err != nil {
+		if errors.Is(err, storage.ErrInvalidID) {
+			jsonapi.WriteError(c, http.StatusBadRequest, "Invalid car ID", "", nil, gin.H{"error": "Invalid car ID"})
+			return
+		}
+		if errors.Is(err, storage.ErrNotFound) {
+			jsonapi.WriteError(c, http.StatusNotFound, "Car not found", "", nil, gin.H{"error": "Car not found"})
+			return
+		}
+		jsonapi.WriteError(c, http.StatusInternalServerError, "Failed to delete car", "", nil, gin.H{"error": "Failed to delete car"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,165 @@
+package car
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/jsonapi"
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+func newTestRouter(storer *fakeCarStorer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	ctrl := NewController(storer)
+
+	router := gin.New()
+	router.Use(jsonapi.Negotiate())
+	router.GET("/cars", ctrl.GetAll)
+	router.POST("/cars", ctrl.Create)
+	router.POST("/cars/bulk", ctrl.CreateBulk)
+	router.GET("/cars/:id", ctrl.GetByID)
+	router.PUT("/cars/:id", ctrl.Update)
+	router.DELETE("/cars/:id", ctrl.Delete)
+	return router
+}
+
+func TestControllerGetAll_PaginationLinksPreserveQuery(t *testing.T) {
+	storer := &fakeCarStorer{cars: []storage.Car{
+		{ID: "1", Make: "Toyota"}, {ID: "2", Make: "Honda"},
+	}}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodGet, "/cars?make=Toyota&page=1&page_size=1", nil)
+	req.Header.Set("Accept", "application/vnd.api+json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	if storer.lastFilter.Make != "Toyota" {
+		t.Fatalf("storer received filter %+v, want Make=Toyota", storer.lastFilter)
+	}
+
+	body := w.Body.String()
+	if !strings.Contains(body, "make=Toyota") {
+		t.Fatalf("pagination links dropped the make filter: %s", body)
+	}
+}
+
+func TestControllerCreate_ValidationError(t *testing.T) {
+	storer := &fakeCarStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/cars", strings.NewReader(`{"make":"","model":"Corolla","year":2020,"price":100}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestControllerCreate_Success(t *testing.T) {
+	storer := &fakeCarStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPost, "/cars", strings.NewReader(`{"make":"Toyota","model":"Corolla","year":2020,"price":100}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	if len(storer.cars) != 1 {
+		t.Fatalf("expected storer to hold 1 car, got %d", len(storer.cars))
+	}
+}
+
+func TestControllerGetByID_NotFound(t *testing.T) {
+	storer := &fakeCarStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodGet, "/cars/missing", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestControllerUpdate_NotFound(t *testing.T) {
+	storer := &fakeCarStorer{}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPut, "/cars/missing", strings.NewReader(`{"make":"Toyota","model":"Corolla","year":2020,"price":100}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestControllerGetByID_InvalidID(t *testing.T) {
+	storer := &fakeCarStorer{findErr: storage.ErrInvalidID}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodGet, "/cars/not-a-hex-id", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestControllerUpdate_InvalidID(t *testing.T) {
+	storer := &fakeCarStorer{updateErr: storage.ErrInvalidID}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodPut, "/cars/not-a-hex-id", strings.NewReader(`{"make":"Toyota","model":"Corolla","year":2020,"price":100}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestControllerDelete_InvalidID(t *testing.T) {
+	storer := &fakeCarStorer{deleteErr: storage.ErrInvalidID}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodDelete, "/cars/not-a-hex-id", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestControllerDelete_Success(t *testing.T) {
+	storer := &fakeCarStorer{cars: []storage.Car{{ID: "1", Make: "Toyota"}}}
+	router := newTestRouter(storer)
+
+	req := httptest.NewRequest(http.MethodDelete, "/cars/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	if len(storer.cars) != 0 {
+		t.Fatalf("expected car to be deleted, storer has %d cars", len(storer.cars))
+	}
+}
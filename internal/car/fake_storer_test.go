@@ -0,0 +1,107 @@
+package car
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+)
+
+// fakeCarStorer is an in-memory storage.CarStorer used to unit test
+// Controller without a real database.
+type fakeCarStorer struct {
+	cars       []storage.Car
+	nextID     int
+	createErr  error
+	findErr    error
+	findAllErr error
+	updateErr  error
+	deleteErr  error
+
+	lastFilter storage.ListFilter
+	lastOpts   storage.ListOptions
+}
+
+func (f *fakeCarStorer) Create(_ context.Context, car storage.Car) (storage.Car, error) {
+	if f.createErr != nil {
+		return storage.Car{}, f.createErr
+	}
+	f.nextID++
+	car.ID = strconv.Itoa(f.nextID)
+	f.cars = append(f.cars, car)
+	return car, nil
+}
+
+func (f *fakeCarStorer) CreateMany(ctx context.Context, cars []storage.Car) ([]storage.BulkCreateResult, error) {
+	results := make([]storage.BulkCreateResult, len(cars))
+	for i, car := range cars {
+		created, err := f.Create(ctx, car)
+		if err != nil {
+			results[i] = storage.BulkCreateResult{Error: err}
+			continue
+		}
+		results[i] = storage.BulkCreateResult{Car: created}
+	}
+	return results, nil
+}
+
+func (f *fakeCarStorer) Find(_ context.Context, id string) (storage.Car, error) {
+	if f.findErr != nil {
+		return storage.Car{}, f.findErr
+	}
+	for _, car := range f.cars {
+		if car.ID == id {
+			return car, nil
+		}
+	}
+	return storage.Car{}, storage.ErrNotFound
+}
+
+func (f *fakeCarStorer) FindAll(_ context.Context, filter storage.ListFilter, opts storage.ListOptions) ([]storage.Car, int64, error) {
+	f.lastFilter = filter
+	f.lastOpts = opts
+	if f.findAllErr != nil {
+		return nil, 0, f.findAllErr
+	}
+	return f.cars, int64(len(f.cars)), nil
+}
+
+func (f *fakeCarStorer) Export(ctx context.Context, filter storage.ListFilter, fn func(storage.Car) error) error {
+	cars, _, err := f.FindAll(ctx, filter, storage.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, car := range cars {
+		if err := fn(car); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeCarStorer) Update(_ context.Context, id string, car storage.Car) (storage.Car, error) {
+	if f.updateErr != nil {
+		return storage.Car{}, f.updateErr
+	}
+	for i, existing := range f.cars {
+		if existing.ID == id {
+			car.ID = id
+			f.cars[i] = car
+			return car, nil
+		}
+	}
+	return storage.Car{}, storage.ErrNotFound
+}
+
+func (f *fakeCarStorer) Delete(_ context.Context, id string) error {
+	if f.deleteErr != nil {
+		return f.deleteErr
+	}
+	for i, existing := range f.cars {
+		if existing.ID == id {
+			f.cars = append(f.cars[:i], f.cars[i+1:]...)
+			return nil
+		}
+	}
+	return storage.ErrNotFound
+}
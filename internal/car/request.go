@@ -0,0 +1,144 @@
+package car
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultPage     = 1
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// sortableColumns whitelists the fields that may be used in sort_column, to
+// avoid passing arbitrary client input straight into the storage layer.
+var sortableColumns = map[string]bool{
+	"make":  true,
+	"model": true,
+	"year":  true,
+	"price": true,
+}
+
+var (
+	errInvalidPage      = errors.New("page must be a positive integer")
+	errInvalidPageSize  = errors.New("page_size must be a positive integer")
+	errInvalidSortOrder = errors.New("sort_column must be one of: make, model, year, price")
+	errInvalidRange     = errors.New("range filters must be numeric")
+)
+
+// carRequest is the body accepted by create and update. The binding tags
+// drive field-level validation; failures are reported per-field by
+// writeValidationError rather than as one opaque error.
+type carRequest struct {
+	Make  string  `json:"make" binding:"required,min=1,max=64"`
+	Model string  `json:"model" binding:"required,min=1,max=64"`
+	Year  int     `json:"year" binding:"required,gte=1886,lte=2100"`
+	Price float64 `json:"price" binding:"required,gte=0"`
+}
+
+func (r carRequest) toStorageCar() storage.Car {
+	return storage.Car{Make: r.Make, Model: r.Model, Year: r.Year, Price: r.Price}
+}
+
+// parseListOptions reads page, page_size, sort_column and sort_order from
+// the query string, capping page_size at maxPageSize to protect the server.
+func parseListOptions(c *gin.Context) (storage.ListOptions, error) {
+	opts := storage.ListOptions{Page: defaultPage, PageSize: defaultPageSize, SortColumn: "make", SortOrder: 1}
+
+	if raw := c.Query("page"); raw != "" {
+		page, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || page < 1 {
+			return storage.ListOptions{}, errInvalidPage
+		}
+		opts.Page = page
+	}
+
+	if raw := c.Query("page_size"); raw != "" {
+		pageSize, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || pageSize < 1 {
+			return storage.ListOptions{}, errInvalidPageSize
+		}
+		opts.PageSize = pageSize
+	}
+	if opts.PageSize > maxPageSize {
+		opts.PageSize = maxPageSize
+	}
+
+	if sortColumn := c.Query("sort_column"); sortColumn != "" {
+		if !sortableColumns[sortColumn] {
+			return storage.ListOptions{}, errInvalidSortOrder
+		}
+		opts.SortColumn = sortColumn
+	}
+	if c.DefaultQuery("sort_order", "asc") == "desc" {
+		opts.SortOrder = -1
+	}
+
+	return opts, nil
+}
+
+// parseListFilter reads make, model, year_min/year_max, price_min/price_max,
+// and q (free-text match across make/model) from the query string.
+func parseListFilter(c *gin.Context) (storage.ListFilter, error) {
+	filter := storage.ListFilter{
+		Make:  c.Query("make"),
+		Model: c.Query("model"),
+		Query: c.Query("q"),
+	}
+
+	yearMin, yearMax, err := intRange(c.Query("year_min"), c.Query("year_max"))
+	if err != nil {
+		return storage.ListFilter{}, err
+	}
+	filter.YearMin, filter.YearMax = yearMin, yearMax
+
+	priceMin, priceMax, err := floatRange(c.Query("price_min"), c.Query("price_max"))
+	if err != nil {
+		return storage.ListFilter{}, err
+	}
+	filter.PriceMin, filter.PriceMax = priceMin, priceMax
+
+	return filter, nil
+}
+
+func intRange(min, max string) (*int, *int, error) {
+	var minPtr, maxPtr *int
+	if min != "" {
+		v, err := strconv.Atoi(min)
+		if err != nil {
+			return nil, nil, errInvalidRange
+		}
+		minPtr = &v
+	}
+	if max != "" {
+		v, err := strconv.Atoi(max)
+		if err != nil {
+			return nil, nil, errInvalidRange
+		}
+		maxPtr = &v
+	}
+	return minPtr, maxPtr, nil
+}
+
+func floatRange(min, max string) (*float64, *float64, error) {
+	var minPtr, maxPtr *float64
+	if min != "" {
+		v, err := strconv.ParseFloat(min, 64)
+		if err != nil {
+			return nil, nil, errInvalidRange
+		}
+		minPtr = &v
+	}
+	if max != "" {
+		v, err := strconv.ParseFloat(max, 64)
+		if err != nil {
+			return nil, nil, errInvalidRange
+		}
+		maxPtr = &v
+	}
+	return minPtr, maxPtr, nil
+}
@@ -0,0 +1,130 @@
+package car
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+func ginContext(url string) *gin.Context {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c
+}
+
+func TestParseListOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		url     string
+		want    storage.ListOptions
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			url:  "/cars",
+			want: storage.ListOptions{Page: defaultPage, PageSize: defaultPageSize, SortColumn: "make", SortOrder: 1},
+		},
+		{
+			name: "explicit page and page_size",
+			url:  "/cars?page=3&page_size=10",
+			want: storage.ListOptions{Page: 3, PageSize: 10, SortColumn: "make", SortOrder: 1},
+		},
+		{
+			name: "page_size capped at max",
+			url:  "/cars?page_size=1000",
+			want: storage.ListOptions{Page: defaultPage, PageSize: maxPageSize, SortColumn: "make", SortOrder: 1},
+		},
+		{
+			name: "sort_column and desc order",
+			url:  "/cars?sort_column=price&sort_order=desc",
+			want: storage.ListOptions{Page: defaultPage, PageSize: defaultPageSize, SortColumn: "price", SortOrder: -1},
+		},
+		{
+			name:    "invalid page",
+			url:     "/cars?page=0",
+			wantErr: errInvalidPage,
+		},
+		{
+			name:    "non-numeric page_size",
+			url:     "/cars?page_size=abc",
+			wantErr: errInvalidPageSize,
+		},
+		{
+			name:    "unknown sort_column",
+			url:     "/cars?sort_column=color",
+			wantErr: errInvalidSortOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseListOptions(ginContext(tt.url))
+			if tt.wantErr != nil {
+				if err != tt.wantErr {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseListFilter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("plain fields", func(t *testing.T) {
+		filter, err := parseListFilter(ginContext("/cars?make=Toyota&model=Corolla&q=corol"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if filter.Make != "Toyota" || filter.Model != "Corolla" || filter.Query != "corol" {
+			t.Fatalf("got %+v", filter)
+		}
+	})
+
+	t.Run("year and price range", func(t *testing.T) {
+		filter, err := parseListFilter(ginContext("/cars?year_min=2000&year_max=2020&price_min=1000&price_max=2000.5"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if filter.YearMin == nil || *filter.YearMin != 2000 {
+			t.Fatalf("YearMin = %v", filter.YearMin)
+		}
+		if filter.YearMax == nil || *filter.YearMax != 2020 {
+			t.Fatalf("YearMax = %v", filter.YearMax)
+		}
+		if filter.PriceMin == nil || *filter.PriceMin != 1000 {
+			t.Fatalf("PriceMin = %v", filter.PriceMin)
+		}
+		if filter.PriceMax == nil || *filter.PriceMax != 2000.5 {
+			t.Fatalf("PriceMax = %v", filter.PriceMax)
+		}
+	})
+
+	t.Run("invalid year range", func(t *testing.T) {
+		_, err := parseListFilter(ginContext("/cars?year_min=abc"))
+		if err != errInvalidRange {
+			t.Fatalf("err = %v, want %v", err, errInvalidRange)
+		}
+	})
+
+	t.Run("invalid price range", func(t *testing.T) {
+		_, err := parseListFilter(ginContext("/cars?price_max=abc"))
+		if err != errInvalidRange {
+			t.Fatalf("err = %v, want %v", err, errInvalidRange)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package car
+
+import "github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+
+// carResponse is the JSON representation of a car returned to clients. The
+// jsonapi tags drive the alternate JSON:API encoding negotiated via the
+// Accept header; see internal/jsonapi.
+type carResponse struct {
+	ID    string  `json:"id" jsonapi:"primary,cars"`
+	Make  string  `json:"make" jsonapi:"attr,make"`
+	Model string  `json:"model" jsonapi:"attr,model"`
+	Year  int     `json:"year" jsonapi:"attr,year"`
+	Price float64 `json:"price" jsonapi:"attr,price"`
+}
+
+// JSONAPISelfLink implements jsonapi.Linkable.
+func (c carResponse) JSONAPISelfLink() string {
+	return "/car/" + c.ID
+}
+
+func newCarResponse(c storage.Car) carResponse {
+	return carResponse{ID: c.ID, Make: c.Make, Model: c.Model, Year: c.Year, Price: c.Price}
+}
+
+// listResponse is the JSON envelope returned by GET /cars.
+type listResponse struct {
+	Items    []carResponse `json:"items"`
+	Page     int64         `json:"page"`
+	PageSize int64         `json:"page_size"`
+	Total    int64         `json:"total"`
+}
+
+func newListResponse(cars []storage.Car, opts storage.ListOptions, total int64) listResponse {
+	items := make([]carResponse, len(cars))
+	for i, c := range cars {
+		items[i] = newCarResponse(c)
+	}
+
+	return listResponse{
+		Items:    items,
+		Page:     opts.Page,
+		PageSize: opts.PageSize,
+		Total:    total,
+	}
+}
+
+// bulkCreateItemResponse is the per-item outcome reported by POST
+// /cars/bulk.
+type bulkCreateItemResponse struct {
+	Index int          `json:"index"`
+	Car   *carResponse `json:"car,omitempty"`
+	Error string       `json:"error,omitempty"`
+}
+
+// bulkCreateResponse is the JSON envelope returned by POST /cars/bulk.
+type bulkCreateResponse struct {
+	Inserted int                      `json:"inserted"`
+	Failed   int                      `json:"failed"`
+	Items    []bulkCreateItemResponse `json:"items"`
+}
+
+func newBulkCreateResponse(results []storage.BulkCreateResult) bulkCreateResponse {
+	resp := bulkCreateResponse{Items: make([]bulkCreateItemResponse, len(results))}
+
+	for i, result := range results {
+		if result.Error != nil {
+			resp.Items[i] = bulkCreateItemResponse{Index: i, Error: result.Error.Error()}
+			resp.Failed++
+			continue
+		}
+
+		car := newCarResponse(result.Car)
+		resp.Items[i] = bulkCreateItemResponse{Index: i, Car: &car}
+		resp.Inserted++
+	}
+
+	return resp
+}
+
+// importRowError reports a single row that failed validation or insertion
+// during POST /cars/import.
+type importRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// importResponse is the JSON envelope returned by POST /cars/import.
+type importResponse struct {
+	DryRun   bool             `json:"dry_run"`
+	Valid    int              `json:"valid"`
+	Invalid  int              `json:"invalid"`
+	Inserted int              `json:"inserted"`
+	Errors   []importRowError `json:"errors,omitempty"`
+}
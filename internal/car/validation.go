@@ -0,0 +1,151 @@
+package car
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/jsonapi"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// fieldErrorResponse is a single field-level validation failure, returned
+// alongside HTTP 422 so a frontend can display it next to the offending
+// input instead of one opaque error message.
+type fieldErrorResponse struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+var validate = newValidator()
+
+// newValidator returns a validator.Validate configured to read the same
+// "binding" struct tags gin's own ShouldBindJSON validation uses, so
+// validateCarRequest enforces identical rules.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.SetTagName("binding")
+	return v
+}
+
+// validateCarRequest runs req through the same binding rules ShouldBindJSON
+// enforces on POST/PUT, returning a single error joining every failed
+// field's message. Used by CSV import, which can't rely on gin's binding
+// to validate rows parsed by hand.
+func validateCarRequest(req carRequest) error {
+	fields := fieldErrorsForRequest(req)
+	if fields == nil {
+		return nil
+	}
+
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = field.Message
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// writeValidationError renders the error from a ShouldBindJSON call against
+// carRequest as HTTP 422 with one fieldErrorResponse per failed validation
+// rule. Errors that aren't validation failures (e.g. malformed JSON) fall
+// back to a plain HTTP 400.
+func writeValidationError(c *gin.Context, err error) {
+	fields := fieldErrorsFrom(err)
+	if fields == nil {
+		jsonapi.WriteError(c, http.StatusBadRequest, "Invalid car data", err.Error(), nil, gin.H{"error": "Invalid car data"})
+		return
+	}
+
+	writeFieldErrors(c, fields)
+}
+
+// writeFieldErrors renders fields as HTTP 422, one JSON:API error object per
+// failed validation rule.
+func writeFieldErrors(c *gin.Context, fields []fieldErrorResponse) {
+	apiErrors := make([]jsonapi.ErrorObject, len(fields))
+	for i, field := range fields {
+		apiErrors[i] = jsonapi.ErrorObject{
+			Status: strconv.Itoa(http.StatusUnprocessableEntity),
+			Title:  "Validation failed",
+			Detail: field.Message,
+			Source: &jsonapi.ErrorSource{Pointer: "/data/attributes/" + field.Field},
+		}
+	}
+
+	jsonapi.WriteErrors(c, http.StatusUnprocessableEntity, apiErrors, gin.H{"errors": fields})
+}
+
+// fieldErrorsFrom extracts one fieldErrorResponse per failed validation rule
+// from a ShouldBindJSON error against a single carRequest, or nil if err
+// isn't a validation failure.
+func fieldErrorsFrom(err error) []fieldErrorResponse {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+	return fieldErrorsFromValidationErrors(validationErrs)
+}
+
+// fieldErrorsForRequest validates req directly (bypassing gin's binding, so
+// callers that already hold a decoded carRequest don't need a
+// validator.ValidationErrors to unwrap), returning one fieldErrorResponse
+// per failed rule, or nil if req is valid.
+func fieldErrorsForRequest(req carRequest) []fieldErrorResponse {
+	err := validate.Struct(req)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+	return fieldErrorsFromValidationErrors(validationErrs)
+}
+
+func fieldErrorsFromValidationErrors(validationErrs validator.ValidationErrors) []fieldErrorResponse {
+	fields := make([]fieldErrorResponse, len(validationErrs))
+	for i, fe := range validationErrs {
+		field := strings.ToLower(fe.Field())
+		fields[i] = fieldErrorResponse{Field: field, Rule: fe.Tag(), Message: fieldErrorMessage(fe, field)}
+	}
+	return fields
+}
+
+// fieldErrorsForBulk validates each item in reqs individually and prefixes
+// each failed field with its index in reqs. Gin's own []carRequest binding
+// validation (binding.SliceValidationError) only reports the position among
+// failed items, not the original index, which would mislabel which item in
+// the request actually failed — so CreateBulk validates item-by-item
+// instead of relying on it.
+func fieldErrorsForBulk(reqs []carRequest) []fieldErrorResponse {
+	var fields []fieldErrorResponse
+	for itemIndex, req := range reqs {
+		for _, field := range fieldErrorsForRequest(req) {
+			field.Field = fmt.Sprintf("%d.%s", itemIndex, field.Field)
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func fieldErrorMessage(fe validator.FieldError, field string) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", field)
+	case "min":
+		return fmt.Sprintf("%s must be at least %s characters", field, fe.Param())
+	case "max":
+		return fmt.Sprintf("%s must be at most %s characters", field, fe.Param())
+	case "gte":
+		return fmt.Sprintf("%s must be >= %s", field, fe.Param())
+	case "lte":
+		return fmt.Sprintf("%s must be <= %s", field, fe.Param())
+	default:
+		return fmt.Sprintf("%s is invalid", field)
+	}
+}
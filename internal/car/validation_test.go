@@ -0,0 +1,53 @@
+package car
+
+import "testing"
+
+func TestValidateCarRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     carRequest
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			req:  carRequest{Make: "Toyota", Model: "Corolla", Year: 2020, Price: 19999.99},
+		},
+		{
+			name:    "year too old",
+			req:     carRequest{Make: "Ford", Model: "Model T", Year: 1885, Price: 500},
+			wantErr: true,
+		},
+		{
+			name:    "year too new",
+			req:     carRequest{Make: "Toyota", Model: "Corolla", Year: 2101, Price: 500},
+			wantErr: true,
+		},
+		{
+			name:    "negative price",
+			req:     carRequest{Make: "Toyota", Model: "Corolla", Year: 2020, Price: -1},
+			wantErr: true,
+		},
+		{
+			name:    "empty make",
+			req:     carRequest{Make: "", Model: "Corolla", Year: 2020, Price: 500},
+			wantErr: true,
+		},
+		{
+			name:    "make too long",
+			req:     carRequest{Make: string(make([]byte, 65)), Model: "Corolla", Year: 2020, Price: 500},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCarRequest(tt.req)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Package health exposes liveness and readiness routes for running the
+// service behind a load balancer or Kubernetes.
+package health
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultPingTimeout = 2 * time.Second
+
+// PingFunc checks that a dependency (e.g. the database) is reachable.
+type PingFunc func(ctx context.Context) error
+
+// Controller handles the ping, liveness, and readiness routes.
+type Controller struct {
+	ping PingFunc
+}
+
+// NewController returns a Controller that uses ping to check readiness.
+func NewController(ping PingFunc) *Controller {
+	return &Controller{ping: ping}
+}
+
+// Ping responds to GET /api/ping.
+func (ctrl *Controller) Ping(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Live responds to GET /healthz: the process is up and serving requests.
+func (ctrl *Controller) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Ready responds to GET /readyz: the process is up and its dependencies are
+// reachable. It returns 503 when the ping fails.
+func (ctrl *Controller) Ready(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), defaultPingTimeout)
+	defer cancel()
+
+	if err := ctrl.ping(ctx); err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unavailable", "error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
@@ -0,0 +1,214 @@
+// Package jsonapi implements a minimal JSON:API 1.0 encoder, used as an
+// alternate response format selected via content negotiation (see
+// Negotiate). It marshals plain Go structs by reading `jsonapi` struct
+// tags rather than requiring response types to build the envelope
+// themselves.
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Linkable is implemented by response types that know their own JSON:API
+// self link. Types that don't implement it are marshaled without a "links"
+// member.
+type Linkable interface {
+	JSONAPISelfLink() string
+}
+
+// ResourceObject is a single JSON:API resource object.
+type ResourceObject struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Attributes map[string]interface{} `json:"attributes"`
+	Links      *ResourceLinks         `json:"links,omitempty"`
+}
+
+// ResourceLinks holds the links member of a resource object.
+type ResourceLinks struct {
+	Self string `json:"self"`
+}
+
+// TopLevelLinks holds the pagination links of a collection document.
+type TopLevelLinks struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// Meta holds the pagination meta member of a collection document.
+type Meta struct {
+	Total    int64 `json:"total"`
+	Page     int64 `json:"page"`
+	PageSize int64 `json:"page_size"`
+}
+
+// Document is a top-level JSON:API document.
+type Document struct {
+	Data  interface{}    `json:"data"`
+	Meta  *Meta          `json:"meta,omitempty"`
+	Links *TopLevelLinks `json:"links,omitempty"`
+}
+
+// CollectionMeta describes the pagination state of a collection, used both
+// to populate the document's meta member and to build its pagination
+// links.
+type CollectionMeta struct {
+	Total    int64
+	Page     int64
+	PageSize int64
+	// BasePath is the request path (e.g. "/cars") used to build the
+	// first/prev/next/last links.
+	BasePath string
+	// Query holds the request's other query parameters (filters, sort,
+	// etc.), preserved across pagination links with only page/page_size
+	// overridden. Nil is treated as no extra parameters.
+	Query url.Values
+}
+
+// Marshal encodes a single resource (a struct, or pointer to one, annotated
+// with `jsonapi` tags) as a JSON:API document.
+func Marshal(resource interface{}) ([]byte, error) {
+	obj, err := toResourceObject(resource)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(Document{Data: obj})
+}
+
+// MarshalMany encodes a slice of resources as a JSON:API collection
+// document, including pagination meta and links built from meta.
+func MarshalMany(resources interface{}, meta CollectionMeta) ([]byte, error) {
+	rv := reflect.ValueOf(resources)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("jsonapi: MarshalMany requires a slice, got %s", rv.Kind())
+	}
+
+	objs := make([]ResourceObject, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		obj, err := toResourceObject(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		objs[i] = obj
+	}
+
+	doc := Document{
+		Data:  objs,
+		Meta:  &Meta{Total: meta.Total, Page: meta.Page, PageSize: meta.PageSize},
+		Links: paginationLinks(meta),
+	}
+	return json.Marshal(doc)
+}
+
+func toResourceObject(v interface{}) (ResourceObject, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return ResourceObject{}, fmt.Errorf("jsonapi: %s is not a struct", rv.Kind())
+	}
+
+	obj := ResourceObject{Attributes: map[string]interface{}{}}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("jsonapi")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		switch parts[0] {
+		case "primary":
+			obj.ID = fmt.Sprintf("%v", rv.Field(i).Interface())
+			if len(parts) > 1 {
+				obj.Type = parts[1]
+			}
+		case "attr":
+			name := field.Name
+			if len(parts) > 1 {
+				name = parts[1]
+			}
+			obj.Attributes[name] = rv.Field(i).Interface()
+		}
+	}
+
+	if linkable, ok := v.(Linkable); ok {
+		obj.Links = &ResourceLinks{Self: linkable.JSONAPISelfLink()}
+	}
+
+	return obj, nil
+}
+
+func paginationLinks(meta CollectionMeta) *TopLevelLinks {
+	lastPage := int64(1)
+	if meta.PageSize > 0 {
+		lastPage = (meta.Total + meta.PageSize - 1) / meta.PageSize
+	}
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	links := &TopLevelLinks{
+		First: pageLink(meta.BasePath, meta.Query, 1, meta.PageSize),
+		Last:  pageLink(meta.BasePath, meta.Query, lastPage, meta.PageSize),
+	}
+	if meta.Page > 1 {
+		links.Prev = pageLink(meta.BasePath, meta.Query, meta.Page-1, meta.PageSize)
+	}
+	if meta.Page < lastPage {
+		links.Next = pageLink(meta.BasePath, meta.Query, meta.Page+1, meta.PageSize)
+	}
+	return links
+}
+
+// pageLink builds basePath with query's parameters (filters, sort, ...)
+// preserved and page/page_size overridden, so following a pagination link
+// doesn't silently drop the client's filter/sort criteria.
+func pageLink(basePath string, query url.Values, page, pageSize int64) string {
+	values := url.Values{}
+	for key, vals := range query {
+		values[key] = append([]string(nil), vals...)
+	}
+	values.Set("page", strconv.FormatInt(page, 10))
+	values.Set("page_size", strconv.FormatInt(pageSize, 10))
+	return basePath + "?" + values.Encode()
+}
+
+// ErrorSource identifies what part of the request an error relates to, per
+// the JSON:API spec: either a JSON pointer into the request document, or a
+// query parameter name.
+type ErrorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// ErrorObject is a single JSON:API error.
+type ErrorObject struct {
+	Status string       `json:"status"`
+	Title  string       `json:"title"`
+	Detail string       `json:"detail,omitempty"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+type errorDocument struct {
+	Errors []ErrorObject `json:"errors"`
+}
+
+// MarshalError encodes a single error as a JSON:API error document.
+func MarshalError(status int, title, detail string, source *ErrorSource) ([]byte, error) {
+	return json.Marshal(errorDocument{Errors: []ErrorObject{{
+		Status: strconv.Itoa(status),
+		Title:  title,
+		Detail: detail,
+		Source: source,
+	}}})
+}
@@ -0,0 +1,166 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+type testResource struct {
+	ID   string `jsonapi:"primary,widgets"`
+	Name string `jsonapi:"attr,name"`
+	Size int    `jsonapi:"attr,size"`
+}
+
+func (r testResource) JSONAPISelfLink() string {
+	return "/widgets/" + r.ID
+}
+
+func TestMarshal(t *testing.T) {
+	body, err := Marshal(testResource{ID: "1", Name: "sprocket", Size: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc Document
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	data, ok := doc.Data.(map[string]interface{})
+	if !ok {
+		t.Fatalf("Data is %T, want map[string]interface{}", doc.Data)
+	}
+	if data["type"] != "widgets" || data["id"] != "1" {
+		t.Fatalf("got %+v", data)
+	}
+	attrs, ok := data["attributes"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("attributes is %T", data["attributes"])
+	}
+	if attrs["name"] != "sprocket" {
+		t.Errorf("attributes.name = %v, want sprocket", attrs["name"])
+	}
+	links, ok := data["links"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("links is %T", data["links"])
+	}
+	if links["self"] != "/widgets/1" {
+		t.Errorf("links.self = %v, want /widgets/1", links["self"])
+	}
+}
+
+func TestMarshal_NotAStruct(t *testing.T) {
+	if _, err := Marshal("not a struct"); err == nil {
+		t.Fatal("expected an error for a non-struct resource")
+	}
+}
+
+func TestMarshalMany(t *testing.T) {
+	resources := []testResource{
+		{ID: "1", Name: "sprocket"},
+		{ID: "2", Name: "widget"},
+	}
+
+	body, err := MarshalMany(resources, CollectionMeta{
+		Total: 2, Page: 1, PageSize: 1, BasePath: "/widgets",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc Document
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	data, ok := doc.Data.([]interface{})
+	if !ok || len(data) != 2 {
+		t.Fatalf("Data = %+v", doc.Data)
+	}
+	if doc.Meta == nil || doc.Meta.Total != 2 {
+		t.Fatalf("Meta = %+v", doc.Meta)
+	}
+	if doc.Links == nil || doc.Links.Next == "" {
+		t.Fatalf("Links = %+v, want a next link (page 1 of 2)", doc.Links)
+	}
+}
+
+func TestMarshalMany_NotASlice(t *testing.T) {
+	if _, err := MarshalMany(testResource{}, CollectionMeta{}); err == nil {
+		t.Fatal("expected an error when resources is not a slice")
+	}
+}
+
+func TestPaginationLinksPreserveQueryParams(t *testing.T) {
+	meta := CollectionMeta{
+		Total: 30, Page: 2, PageSize: 10,
+		BasePath: "/cars",
+		Query:    url.Values{"make": {"Toyota"}, "sort_column": {"price"}},
+	}
+
+	links := paginationLinks(meta)
+
+	for _, link := range []string{links.First, links.Prev, links.Next, links.Last} {
+		if link == "" {
+			continue
+		}
+		parsed, err := url.Parse(link)
+		if err != nil {
+			t.Fatalf("parse %q: %v", link, err)
+		}
+		values := parsed.Query()
+		if values.Get("make") != "Toyota" {
+			t.Errorf("link %q dropped make filter", link)
+		}
+		if values.Get("sort_column") != "price" {
+			t.Errorf("link %q dropped sort_column", link)
+		}
+	}
+
+	if links.Prev == "" {
+		t.Fatal("expected a prev link on page 2")
+	}
+	if links.Next == "" {
+		t.Fatal("expected a next link on page 2 of 3")
+	}
+}
+
+func TestPageLink_OverridesPageAndPageSize(t *testing.T) {
+	query := url.Values{"page": {"99"}, "page_size": {"99"}, "q": {"abc"}}
+
+	link := pageLink("/cars", query, 2, 20)
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("parse %q: %v", link, err)
+	}
+	values := parsed.Query()
+	if values.Get("page") != "2" {
+		t.Errorf("page = %s, want 2", values.Get("page"))
+	}
+	if values.Get("page_size") != "20" {
+		t.Errorf("page_size = %s, want 20", values.Get("page_size"))
+	}
+	if values.Get("q") != "abc" {
+		t.Errorf("q = %s, want abc to be preserved", values.Get("q"))
+	}
+}
+
+func TestMarshalError(t *testing.T) {
+	body, err := MarshalError(404, "Not found", "no such car", &ErrorSource{Pointer: "/data/attributes/make"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc errorDocument
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(doc.Errors) != 1 {
+		t.Fatalf("len(Errors) = %d, want 1", len(doc.Errors))
+	}
+	if doc.Errors[0].Status != "404" || doc.Errors[0].Title != "Not found" {
+		t.Fatalf("got %+v", doc.Errors[0])
+	}
+}
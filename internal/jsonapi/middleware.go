@@ -0,0 +1,98 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MediaType is the JSON:API content type. Requesting it via the Accept
+// header selects the JSON:API response format on negotiated routes.
+const MediaType = "application/vnd.api+json"
+
+const contextKey = "jsonapi.requested"
+
+// Negotiate inspects the Accept header and records whether the client asked
+// for JSON:API, so handlers can call Write/WriteMany/WriteError to render
+// whichever format was negotiated. Routes that don't install this
+// middleware always fall back to plain JSON.
+func Negotiate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(contextKey, c.GetHeader("Accept") == MediaType)
+		c.Next()
+	}
+}
+
+// Requested reports whether the client negotiated a JSON:API response.
+func Requested(c *gin.Context) bool {
+	requested, _ := c.Get(contextKey)
+	b, _ := requested.(bool)
+	return b
+}
+
+// Write renders resource at status, using JSON:API encoding if negotiated
+// and falling back to plain JSON otherwise.
+func Write(c *gin.Context, status int, resource interface{}) {
+	if !Requested(c) {
+		c.JSON(status, resource)
+		return
+	}
+
+	body, err := Marshal(resource)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response"})
+		return
+	}
+	c.Data(status, MediaType, body)
+}
+
+// WriteMany renders resources as a JSON:API collection document when
+// negotiated, annotated with meta. Otherwise it falls back to fallback,
+// the existing plain-JSON envelope for the same data.
+func WriteMany(c *gin.Context, status int, resources interface{}, meta CollectionMeta, fallback interface{}) {
+	if !Requested(c) {
+		c.JSON(status, fallback)
+		return
+	}
+
+	body, err := MarshalMany(resources, meta)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response"})
+		return
+	}
+	c.Data(status, MediaType, body)
+}
+
+// WriteErrors renders multiple errors as a single JSON:API error document
+// when negotiated, or falls back to fallback otherwise.
+func WriteErrors(c *gin.Context, status int, errs []ErrorObject, fallback interface{}) {
+	if !Requested(c) {
+		c.JSON(status, fallback)
+		return
+	}
+
+	body, err := json.Marshal(errorDocument{Errors: errs})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response"})
+		return
+	}
+	c.Data(status, MediaType, body)
+}
+
+// WriteError renders a single error as a JSON:API error document when
+// negotiated, using source to point at the offending field or parameter if
+// known, or falls back to fallback otherwise.
+func WriteError(c *gin.Context, status int, title, detail string, source *ErrorSource, fallback interface{}) {
+	if !Requested(c) {
+		c.JSON(status, fallback)
+		return
+	}
+
+	body, err := MarshalError(status, title, detail, source)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response"})
+		return
+	}
+	c.Data(status, MediaType, body)
+}
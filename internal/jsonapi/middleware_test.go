@@ -0,0 +1,106 @@
+package jsonapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{name: "json:api accept header", accept: MediaType, want: true},
+		{name: "no accept header", accept: "", want: false},
+		{name: "plain json accept header", accept: "application/json", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.accept != "" {
+				c.Request.Header.Set("Accept", tt.accept)
+			}
+
+			Negotiate()(c)
+
+			if got := Requested(c); got != tt.want {
+				t.Errorf("Requested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrite_FallsBackToPlainJSONWithoutNegotiation(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	Write(c, http.StatusOK, gin.H{"hello": "world"})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+}
+
+func TestWrite_EncodesJSONAPIWhenNegotiated(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set("Accept", MediaType)
+	Negotiate()(c)
+
+	Write(c, http.StatusOK, testResource{ID: "1", Name: "sprocket"})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != MediaType {
+		t.Errorf("Content-Type = %q, want %q", ct, MediaType)
+	}
+}
+
+func TestWriteMany_FallsBackToFallback(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	WriteMany(c, http.StatusOK, []testResource{}, CollectionMeta{}, gin.H{"items": []string{}})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+}
+
+func TestWriteError_EncodesJSONAPIWhenNegotiated(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set("Accept", MediaType)
+	Negotiate()(c)
+
+	WriteError(c, http.StatusNotFound, "Not found", "no such car", nil, gin.H{"error": "not found"})
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != MediaType {
+		t.Errorf("Content-Type = %q, want %q", ct, MediaType)
+	}
+}
@@ -0,0 +1,35 @@
+// Package middleware holds cross-cutting gin middleware shared by all
+// routes, as opposed to the route-specific middleware in internal/auth.
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the header read for an inbound request ID, and written
+// back on the response.
+const RequestIDHeader = "X-Request-ID"
+
+const requestIDContextKey = "requestId"
+
+// RequestID propagates X-Request-ID from the client, generating one if
+// absent, and exposes it to downstream handlers and the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		}
+
+		c.Set(requestIDContextKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// GetRequestID returns the request ID set by RequestID, or "" if the
+// middleware wasn't installed on this route.
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDContextKey)
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by storer lookups when no matching record exists.
+var ErrNotFound = errors.New("not found")
+
+// ErrAlreadyExists is returned by storer creates that violate a uniqueness
+// constraint (e.g. a duplicate username).
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrInvalidID is returned by storer lookups when the given id isn't a
+// well-formed identifier for the backend (e.g. not a valid Mongo ObjectID
+// hex string), as distinct from a well-formed id that simply isn't found.
+var ErrInvalidID = errors.New("invalid id")
+
+// Car is the storage-layer representation of a car, independent of any
+// particular backend or transport encoding.
+type Car struct {
+	ID    string
+	Make  string
+	Model string
+	Year  int
+	Price float64
+}
+
+// ListFilter describes the criteria a FindAll call should narrow results by.
+// Zero-value fields are treated as "no constraint".
+type ListFilter struct {
+	Make     string
+	Model    string
+	YearMin  *int
+	YearMax  *int
+	PriceMin *float64
+	PriceMax *float64
+	Query    string
+}
+
+// ListOptions describes pagination and sorting for a FindAll call.
+type ListOptions struct {
+	Page       int64
+	PageSize   int64
+	SortColumn string
+	// SortOrder is 1 for ascending, -1 for descending.
+	SortOrder int
+}
+
+// BulkCreateResult is the per-item outcome of a CreateMany call. Exactly one
+// of Car or Error is set, matching the order of the cars passed in.
+type BulkCreateResult struct {
+	Car   Car
+	Error error
+}
+
+// ErrBulkInsertFailed is used as a BulkCreateResult.Error when the backend
+// reports an insert as failed without a more specific reason.
+var ErrBulkInsertFailed = errors.New("insert failed")
+
+// CarStorer is the persistence boundary for cars. Handlers depend on this
+// interface rather than a concrete database client, so storage backends can
+// be swapped or faked in tests.
+type CarStorer interface {
+	Create(ctx context.Context, car Car) (Car, error)
+	// CreateMany inserts cars in a single round-trip, continuing past
+	// individual failures. The returned slice has one result per input car,
+	// in the same order.
+	CreateMany(ctx context.Context, cars []Car) ([]BulkCreateResult, error)
+	Find(ctx context.Context, id string) (Car, error)
+	FindAll(ctx context.Context, filter ListFilter, opts ListOptions) ([]Car, int64, error)
+	// Export streams every car matching filter to fn, without buffering the
+	// whole result set in memory. It stops and returns fn's error if fn
+	// returns one.
+	Export(ctx context.Context, filter ListFilter, fn func(Car) error) error
+	Update(ctx context.Context, id string, car Car) (Car, error)
+	Delete(ctx context.Context, id string) error
+}
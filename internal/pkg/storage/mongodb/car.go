@@ -0,0 +1,257 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// carDocument is the BSON shape stored in the cars collection.
+type carDocument struct {
+	ID    primitive.ObjectID `bson:"_id,omitempty"`
+	Make  string             `bson:"make"`
+	Model string             `bson:"model"`
+	Year  int                `bson:"year"`
+	Price float64            `bson:"price"`
+}
+
+func (d carDocument) toStorageCar() storage.Car {
+	return storage.Car{
+		ID:    d.ID.Hex(),
+		Make:  d.Make,
+		Model: d.Model,
+		Year:  d.Year,
+		Price: d.Price,
+	}
+}
+
+// CarStorer is a MongoDB-backed implementation of storage.CarStorer.
+type CarStorer struct {
+	collection *mongo.Collection
+}
+
+// NewCarStorer returns a CarStorer backed by the "cars" collection of db.
+func NewCarStorer(db *mongo.Database) *CarStorer {
+	return &CarStorer{collection: db.Collection("cars")}
+}
+
+func (s *CarStorer) Create(ctx context.Context, car storage.Car) (storage.Car, error) {
+	doc := carDocument{Make: car.Make, Model: car.Model, Year: car.Year, Price: car.Price}
+
+	result, err := s.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return storage.Car{}, err
+	}
+
+	doc.ID = result.InsertedID.(primitive.ObjectID)
+	return doc.toStorageCar(), nil
+}
+
+func (s *CarStorer) CreateMany(ctx context.Context, cars []storage.Car) ([]storage.BulkCreateResult, error) {
+	if len(cars) == 0 {
+		return nil, nil
+	}
+
+	docs := make([]interface{}, len(cars))
+	for i, car := range cars {
+		docs[i] = carDocument{Make: car.Make, Model: car.Model, Year: car.Year, Price: car.Price}
+	}
+
+	insertResult, err := s.collection.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+
+	inserted := map[int]primitive.ObjectID{}
+	if insertResult != nil {
+		for index, id := range insertResult.InsertedIDs {
+			inserted[index] = id.(primitive.ObjectID)
+		}
+	}
+
+	failed := map[int]error{}
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) {
+		for _, writeErr := range bulkErr.WriteErrors {
+			failed[writeErr.Index] = writeErr.WriteError
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	results := make([]storage.BulkCreateResult, len(cars))
+	for i, car := range cars {
+		switch {
+		case failed[i] != nil:
+			results[i] = storage.BulkCreateResult{Error: failed[i]}
+		case inserted[i] != primitive.NilObjectID:
+			car.ID = inserted[i].Hex()
+			results[i] = storage.BulkCreateResult{Car: car}
+		default:
+			results[i] = storage.BulkCreateResult{Error: storage.ErrBulkInsertFailed}
+		}
+	}
+
+	return results, nil
+}
+
+func (s *CarStorer) Export(ctx context.Context, filter storage.ListFilter, fn func(storage.Car) error) error {
+	cursor, err := s.collection.Find(ctx, buildFilter(filter), options.Find().SetSort(bson.D{{Key: "make", Value: 1}}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc carDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if err := fn(doc.toStorageCar()); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func (s *CarStorer) Find(ctx context.Context, id string) (storage.Car, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return storage.Car{}, storage.ErrInvalidID
+	}
+
+	var doc carDocument
+	if err := s.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return storage.Car{}, storage.ErrNotFound
+		}
+		return storage.Car{}, err
+	}
+
+	return doc.toStorageCar(), nil
+}
+
+func (s *CarStorer) FindAll(ctx context.Context, filter storage.ListFilter, opts storage.ListOptions) ([]storage.Car, int64, error) {
+	bsonFilter := buildFilter(filter)
+
+	total, err := s.collection.CountDocuments(ctx, bsonFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	findOptions := options.Find().
+		SetSkip((opts.Page - 1) * opts.PageSize).
+		SetLimit(opts.PageSize).
+		SetSort(bson.D{{Key: opts.SortColumn, Value: opts.SortOrder}})
+
+	cursor, err := s.collection.Find(ctx, bsonFilter, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []carDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, 0, err
+	}
+
+	cars := make([]storage.Car, len(docs))
+	for i, doc := range docs {
+		cars[i] = doc.toStorageCar()
+	}
+
+	return cars, total, nil
+}
+
+func (s *CarStorer) Update(ctx context.Context, id string, car storage.Car) (storage.Car, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return storage.Car{}, storage.ErrInvalidID
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"make":  car.Make,
+			"model": car.Model,
+			"year":  car.Year,
+			"price": car.Price,
+		},
+	}
+
+	result, err := s.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return storage.Car{}, err
+	}
+	if result.MatchedCount == 0 {
+		return storage.Car{}, storage.ErrNotFound
+	}
+
+	car.ID = id
+	return car, nil
+}
+
+func (s *CarStorer) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return storage.ErrInvalidID
+	}
+
+	result, err := s.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func buildFilter(filter storage.ListFilter) bson.M {
+	bsonFilter := bson.M{}
+
+	if filter.Make != "" {
+		bsonFilter["make"] = filter.Make
+	}
+	if filter.Model != "" {
+		bsonFilter["model"] = filter.Model
+	}
+
+	if yearRange := rangeFilter(filter.YearMin, filter.YearMax); yearRange != nil {
+		bsonFilter["year"] = yearRange
+	}
+	if priceRange := rangeFilter(filter.PriceMin, filter.PriceMax); priceRange != nil {
+		bsonFilter["price"] = priceRange
+	}
+
+	if filter.Query != "" {
+		// QuoteMeta so client-supplied text can't inject regex
+		// metacharacters (ReDoS, unintended wildcards, etc.).
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(filter.Query), Options: "i"}
+		bsonFilter["$or"] = bson.A{
+			bson.M{"make": pattern},
+			bson.M{"model": pattern},
+		}
+	}
+
+	return bsonFilter
+}
+
+func rangeFilter[T int | float64](min, max *T) bson.M {
+	if min == nil && max == nil {
+		return nil
+	}
+
+	rng := bson.M{}
+	if min != nil {
+		rng["$gte"] = *min
+	}
+	if max != nil {
+		rng["$lte"] = *max
+	}
+	return rng
+}
@@ -0,0 +1,43 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DatabaseConfig holds the connection settings for the MongoDB database,
+// sourced from environment variables by the caller.
+type DatabaseConfig struct {
+	Auth string
+	Host string
+	Port string
+	User string
+	Pass string
+	Name string
+}
+
+func (c DatabaseConfig) uri() string {
+	if c.User == "" && c.Pass == "" {
+		return fmt.Sprintf("mongodb://%s:%s", c.Host, c.Port)
+	}
+	return fmt.Sprintf("mongodb://%s:%s@%s:%s/?authSource=%s", c.User, c.Pass, c.Host, c.Port, c.Auth)
+}
+
+// NewDatabase connects to MongoDB using cfg and returns the named database.
+// The client is also returned so the caller can health-check it and
+// disconnect it on shutdown.
+func NewDatabase(ctx context.Context, cfg DatabaseConfig) (*mongo.Database, *mongo.Client, error) {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.uri()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to mongodb: %w", err)
+	}
+
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, nil, fmt.Errorf("ping mongodb: %w", err)
+	}
+
+	return client.Database(cfg.Name), client, nil
+}
@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/A-mo21/CarStore_dummyProject/internal/pkg/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// userDocument is the BSON shape stored in the users collection.
+type userDocument struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Username     string             `bson:"username"`
+	PasswordHash string             `bson:"password_hash"`
+	Role         string             `bson:"role"`
+}
+
+func (d userDocument) toStorageUser() storage.User {
+	return storage.User{
+		ID:           d.ID.Hex(),
+		Username:     d.Username,
+		PasswordHash: d.PasswordHash,
+		Role:         d.Role,
+	}
+}
+
+// UserStorer is a MongoDB-backed implementation of storage.UserStorer.
+type UserStorer struct {
+	collection *mongo.Collection
+}
+
+// NewUserStorer returns a UserStorer backed by the "users" collection of db,
+// ensuring a unique index on username so Create's duplicate-key handling
+// has something to rely on.
+func NewUserStorer(ctx context.Context, db *mongo.Database) (*UserStorer, error) {
+	collection := db.Collection("users")
+
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create users.username unique index: %w", err)
+	}
+
+	return &UserStorer{collection: collection}, nil
+}
+
+func (s *UserStorer) Create(ctx context.Context, user storage.User) (storage.User, error) {
+	doc := userDocument{Username: user.Username, PasswordHash: user.PasswordHash, Role: user.Role}
+
+	result, err := s.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return storage.User{}, storage.ErrAlreadyExists
+		}
+		return storage.User{}, err
+	}
+
+	doc.ID = result.InsertedID.(primitive.ObjectID)
+	return doc.toStorageUser(), nil
+}
+
+func (s *UserStorer) FindByUsername(ctx context.Context, username string) (storage.User, error) {
+	var doc userDocument
+	if err := s.collection.FindOne(ctx, bson.M{"username": username}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return storage.User{}, storage.ErrNotFound
+		}
+		return storage.User{}, err
+	}
+
+	return doc.toStorageUser(), nil
+}
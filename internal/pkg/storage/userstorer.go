@@ -0,0 +1,17 @@
+package storage
+
+import "context"
+
+// User is the storage-layer representation of an account.
+type User struct {
+	ID           string
+	Username     string
+	PasswordHash string
+	Role         string
+}
+
+// UserStorer is the persistence boundary for user accounts.
+type UserStorer interface {
+	Create(ctx context.Context, user User) (User, error)
+	FindByUsername(ctx context.Context, username string) (User, error)
+}